@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"replicate.ai/cli/pkg/cli/list"
+	"replicate.ai/cli/pkg/config"
+	"replicate.ai/cli/pkg/param"
+	"replicate.ai/cli/pkg/storage"
+)
+
+func init() {
+	rootCmd.AddCommand(newListCommand())
+}
+
+func newListCommand() *cobra.Command {
+	var formatName string
+	var fields []string
+	var aggregateNames []string
+	var filterArgs []string
+	var sortName string
+	var templatePath string
+	var allParams bool
+	var limit int
+	var offset int
+	var workers int
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List experiments in the current project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return listExperiments(cmd.Context(), listExperimentsOptions{
+				formatName:     formatName,
+				fields:         fields,
+				aggregateNames: aggregateNames,
+				filterArgs:     filterArgs,
+				sortName:       sortName,
+				templatePath:   templatePath,
+				allParams:      allParams,
+				limit:          limit,
+				offset:         offset,
+				workers:        workers,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&formatName, "format", "f", string(list.FormatTable), "Output format: table, json, csv, tsv, quiet, or template")
+	cmd.Flags().StringSliceVar(&fields, "fields", nil, "Columns to display, e.g. --fields=params.lr,metrics.best.loss")
+	cmd.Flags().StringSliceVar(&aggregateNames, "aggregate", nil, "Summary stats to append to the output, e.g. --aggregate=mean,stddev")
+	cmd.Flags().StringArrayVar(&filterArgs, "filter", nil, "Filter experiments, e.g. --filter=params.lr>0.01")
+	cmd.Flags().StringVar(&sortName, "sort", "started", "Field to sort experiments by")
+	cmd.Flags().StringVar(&templatePath, "template", "", "Path to a Go template file; implies --format=template")
+	cmd.Flags().BoolVar(&allParams, "all-params", false, "Show every parameter, not just the ones that differ between experiments")
+	cmd.Flags().IntVar(&limit, "limit", 0, "Maximum number of experiments to show; also the only thing that keeps memory flat as the project grows, since an unlimited listing holds every matching experiment at once")
+	cmd.Flags().IntVar(&offset, "offset", 0, "Number of experiments to skip")
+	cmd.Flags().IntVar(&workers, "workers", 0, "Number of concurrent workers used to resolve experiments (default: GOMAXPROCS)")
+
+	return cmd
+}
+
+type listExperimentsOptions struct {
+	formatName     string
+	fields         []string
+	aggregateNames []string
+	filterArgs     []string
+	sortName       string
+	templatePath   string
+	allParams      bool
+	limit          int
+	offset         int
+	workers        int
+}
+
+const formatTemplate list.Format = "template"
+
+func listExperiments(ctx context.Context, opts listExperimentsOptions) error {
+	if opts.templatePath != "" {
+		renderer, err := list.NewTemplateRenderer(opts.templatePath)
+		if err != nil {
+			return err
+		}
+		list.RegisterRenderer(string(formatTemplate), renderer)
+		opts.formatName = string(formatTemplate)
+	}
+
+	format, err := list.ParseFormat(opts.formatName)
+	if err != nil {
+		return err
+	}
+
+	// aggregateNames is nil when --aggregate wasn't passed, which leaves
+	// aggregation off; --aggregate= with no stats selects list.DefaultStats.
+	var aggregateStats []list.Stat
+	if opts.aggregateNames != nil {
+		aggregateStats, err = list.ParseStats(opts.aggregateNames)
+		if err != nil {
+			return err
+		}
+	}
+
+	filters, err := param.ParseFilters(opts.filterArgs)
+	if err != nil {
+		return err
+	}
+
+	sorter, err := param.ParseSorter(opts.sortName)
+	if err != nil {
+		return err
+	}
+
+	store, err := getProjectStorage()
+	if err != nil {
+		return err
+	}
+
+	return list.Experiments(ctx, store, format, opts.allParams, opts.fields, aggregateStats, filters, sorter, opts.limit, opts.offset, opts.workers)
+}
+
+// getProjectStorage resolves the storage backend for the project rooted at
+// the current working directory, the same way every other command in this
+// CLI locates its storage.
+func getProjectStorage() (storage.Storage, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	conf, err := config.LoadProjectConfig(cwd)
+	if err != nil {
+		return nil, err
+	}
+	return storage.NewStorage(conf.StorageURL)
+}
@@ -1,13 +1,22 @@
 package list
 
 import (
+	"container/heap"
+	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"math"
 	"os"
+	"path/filepath"
+	"runtime"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"text/tabwriter"
+	"text/template"
 	"time"
 
 	"replicate.ai/cli/pkg/config"
@@ -18,17 +27,298 @@ import (
 	"replicate.ai/cli/pkg/storage"
 )
 
-type Format int
+// Format is a registered renderer name, resolved through the Renderer
+// registry below. It used to be a closed set of int constants; it's now a
+// string so third parties can RegisterRenderer their own names.
+type Format string
 
 const (
-	FormatJSON = iota
-	FormatTable
-	FormatQuiet
+	FormatJSON  Format = "json"
+	FormatTable Format = "table"
+	FormatQuiet Format = "quiet"
+	FormatCSV   Format = "csv"
+	FormatTSV   Format = "tsv"
 )
 
+// ParseFormat converts a --format flag value into a Format, validating it
+// against the renderers currently registered.
+func ParseFormat(name string) (Format, error) {
+	if _, ok := renderers[name]; !ok {
+		return "", fmt.Errorf("Unknown format: %s", name)
+	}
+	return Format(name), nil
+}
+
+// RenderOptions carries everything a Renderer needs to produce output for one
+// invocation of list.Experiments.
+type RenderOptions struct {
+	AllParams      bool
+	Columns        []ColumnSpec
+	AggregateStats []Stat
+}
+
+// Renderer turns a slice of experiments into output on os.Stdout (or
+// wherever else it chooses to write). Third parties can implement their own
+// and register it under a new format name with RegisterRenderer, without
+// modifying this package.
+type Renderer interface {
+	Render(experiments []*ListExperiment, opts RenderOptions) error
+}
+
+// StreamingRenderer is implemented by renderers that can write experiments as
+// they arrive on a channel rather than from a slice. Experiments uses it when
+// neither --limit nor --offset is set; the result set is still sorted, and so
+// held in memory in full, before the first experiment reaches RenderStream.
+type StreamingRenderer interface {
+	Renderer
+	RenderStream(experiments <-chan *ListExperiment, opts RenderOptions) error
+}
+
+var renderers = map[string]Renderer{}
+
+// RegisterRenderer makes a Renderer available under the given --format name.
+// Registering under an existing name replaces it.
+func RegisterRenderer(name string, r Renderer) {
+	renderers[name] = r
+}
+
+func init() {
+	RegisterRenderer(string(FormatJSON), jsonRenderer{})
+	RegisterRenderer(string(FormatTable), tableRenderer{})
+	RegisterRenderer(string(FormatQuiet), quietRenderer{})
+	RegisterRenderer(string(FormatCSV), csvRenderer{delimiter: ','})
+	RegisterRenderer(string(FormatTSV), csvRenderer{delimiter: '\t'})
+}
+
+type jsonRenderer struct{}
+
+func (jsonRenderer) Render(experiments []*ListExperiment, opts RenderOptions) error {
+	return outputJSON(experiments, opts.AllParams, opts.Columns, opts.AggregateStats)
+}
+
+// RenderStream writes experiments as a JSON array, one enc.Encode call per
+// element between manually written '[' / ']' brackets, so the whole slice
+// never needs to exist in memory at once. With opts.Columns or
+// opts.AggregateStats set, it collects the experiments and hands them to
+// Render instead, so the output is the same projected rows and summary that
+// outputJSON produces.
+func (jsonRenderer) RenderStream(experiments <-chan *ListExperiment, opts RenderOptions) error {
+	if len(opts.Columns) > 0 || len(opts.AggregateStats) > 0 {
+		buffered := []*ListExperiment{}
+		for exp := range experiments {
+			buffered = append(buffered, exp)
+		}
+		return jsonRenderer{}.Render(buffered, opts)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+
+	if _, err := fmt.Fprint(os.Stdout, "[\n"); err != nil {
+		return err
+	}
+	first := true
+	for exp := range experiments {
+		if !first {
+			if _, err := fmt.Fprint(os.Stdout, ",\n"); err != nil {
+				return err
+			}
+		}
+		first = false
+		if err := enc.Encode(exp); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprint(os.Stdout, "]\n")
+	return err
+}
+
+type tableRenderer struct{}
+
+func (tableRenderer) Render(experiments []*ListExperiment, opts RenderOptions) error {
+	return outputTable(experiments, opts.AllParams, opts.Columns, opts.AggregateStats)
+}
+
+type quietRenderer struct{}
+
+func (quietRenderer) Render(experiments []*ListExperiment, opts RenderOptions) error {
+	return outputQuiet(experiments)
+}
+
+func (quietRenderer) RenderStream(experiments <-chan *ListExperiment, opts RenderOptions) error {
+	for exp := range experiments {
+		fmt.Println(exp.ID)
+	}
+	return nil
+}
+
+type csvRenderer struct {
+	delimiter rune
+}
+
+func (r csvRenderer) Render(experiments []*ListExperiment, opts RenderOptions) error {
+	return outputCSV(experiments, opts.AllParams, opts.Columns, opts.AggregateStats, r.delimiter)
+}
+
+// templateRenderer executes a user-supplied text/template against the
+// experiment slice, for downstream users who want Markdown/HTML/YAML/whatever
+// without compiling a Renderer into the binary.
+type templateRenderer struct {
+	tmpl *template.Template
+}
+
+func (r templateRenderer) Render(experiments []*ListExperiment, opts RenderOptions) error {
+	return r.tmpl.Execute(os.Stdout, experiments)
+}
+
+// NewTemplateRenderer parses the text/template at path and returns a Renderer
+// that executes it against the experiment slice. Callers typically register
+// it under a name (e.g. "template") via RegisterRenderer before passing that
+// name as the --format value.
+func NewTemplateRenderer(path string) (Renderer, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := template.New(filepath.Base(path)).Parse(string(contents))
+	if err != nil {
+		return nil, err
+	}
+	return templateRenderer{tmpl: tmpl}, nil
+}
+
 const valueMaxLength = 20
 const valueTruncate = 5
 
+// Stat is a summary statistic computed for a numeric column in --aggregate mode.
+type Stat string
+
+const (
+	StatMin    Stat = "min"
+	StatMax    Stat = "max"
+	StatMean   Stat = "mean"
+	StatMedian Stat = "median"
+	StatStddev Stat = "stddev"
+)
+
+// DefaultStats is used when --aggregate is passed with no explicit stat list.
+var DefaultStats = []Stat{StatMin, StatMax, StatMean, StatMedian, StatStddev}
+
+// ParseStats turns a comma-separated --aggregate value into an ordered
+// []Stat, falling back to DefaultStats when names is empty.
+func ParseStats(names []string) ([]Stat, error) {
+	if len(names) == 0 {
+		return DefaultStats, nil
+	}
+	stats := make([]Stat, 0, len(names))
+	for _, name := range names {
+		stat := Stat(strings.TrimSpace(name))
+		switch stat {
+		case StatMin, StatMax, StatMean, StatMedian, StatStddev:
+			stats = append(stats, stat)
+		default:
+			return nil, fmt.Errorf("Unknown aggregate stat: %s", name)
+		}
+	}
+	return stats, nil
+}
+
+// runningStats accumulates min/max/mean/variance for a numeric column using
+// Welford's online algorithm, so a second pass over the data isn't needed for
+// mean/stddev. Median still needs every value, so those are kept alongside.
+type runningStats struct {
+	count  int
+	mean   float64
+	m2     float64
+	min    float64
+	max    float64
+	values []float64
+}
+
+func newRunningStats() *runningStats {
+	return &runningStats{min: math.Inf(1), max: math.Inf(-1)}
+}
+
+func (s *runningStats) Add(x float64) {
+	s.count++
+	delta := x - s.mean
+	s.mean += delta / float64(s.count)
+	s.m2 += delta * (x - s.mean)
+	if x < s.min {
+		s.min = x
+	}
+	if x > s.max {
+		s.max = x
+	}
+	s.values = append(s.values, x)
+}
+
+func (s *runningStats) variance() float64 {
+	if s.count < 2 {
+		return 0
+	}
+	return s.m2 / float64(s.count-1)
+}
+
+func (s *runningStats) median() float64 {
+	if s.count == 0 {
+		return 0
+	}
+	sorted := append([]float64{}, s.values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+func (s *runningStats) Value(stat Stat) float64 {
+	switch stat {
+	case StatMin:
+		return s.min
+	case StatMax:
+		return s.max
+	case StatMean:
+		return s.mean
+	case StatMedian:
+		return s.median()
+	case StatStddev:
+		return math.Sqrt(s.variance())
+	}
+	panic(fmt.Sprintf("Unknown stat: %s", stat))
+}
+
+// columnStats computes a runningStats per column, skipping experiments where
+// the column's value is missing or not numeric. A nil entry means the column
+// had no numeric values at all across experiments.
+func columnStats(experiments []*ListExperiment, columns []ColumnSpec) []*runningStats {
+	stats := make([]*runningStats, len(columns))
+	for i, col := range columns {
+		rs := newRunningStats()
+		seenAny := false
+		for _, exp := range experiments {
+			val := exp.GetValue(col.Selector)
+			if val == nil {
+				continue
+			}
+			if val.Type() != param.TypeInt && val.Type() != param.TypeFloat {
+				continue
+			}
+			f, err := val.FloatValue()
+			if err != nil {
+				continue
+			}
+			rs.Add(f)
+			seenAny = true
+		}
+		if seenAny {
+			stats[i] = rs
+		}
+	}
+	return stats
+}
+
 type Metric struct {
 	Primary bool
 	Name    string
@@ -53,6 +343,61 @@ type ListExperiment struct {
 
 // TODO(andreas): make this safer and validate user inputs against these strings
 func (exp *ListExperiment) GetValue(name string) *param.Value {
+	// dotted accessors: params.<name>, metrics.<name>, latest.<name>, best.<name>
+	if dot := strings.IndexByte(name, '.'); dot != -1 {
+		prefix, rest := name[:dot], name[dot+1:]
+		switch prefix {
+		case "params":
+			if val, ok := exp.Params[rest]; ok {
+				return val
+			}
+			return nil
+		case "metrics":
+			// metrics.best.<name> / metrics.latest.<name>, e.g. the
+			// metrics.best.loss form from --fields docs/examples.
+			if nested := strings.IndexByte(rest, '.'); nested != -1 {
+				nestedPrefix, nestedName := rest[:nested], rest[nested+1:]
+				switch nestedPrefix {
+				case "best":
+					if exp.BestCheckpoint != nil {
+						if val, ok := exp.BestCheckpoint.Metrics[nestedName]; ok {
+							return val
+						}
+					}
+				case "latest":
+					if exp.LatestCheckpoint != nil {
+						if val, ok := exp.LatestCheckpoint.Metrics[nestedName]; ok {
+							return val
+						}
+					}
+				}
+				return nil
+			}
+			// metrics.<name> with no checkpoint qualifier defaults to the
+			// best checkpoint, same as the bare <name> fallback below.
+			if exp.BestCheckpoint != nil {
+				if val, ok := exp.BestCheckpoint.Metrics[rest]; ok {
+					return val
+				}
+			}
+			return nil
+		case "latest":
+			if exp.LatestCheckpoint != nil {
+				if val, ok := exp.LatestCheckpoint.Metrics[rest]; ok {
+					return val
+				}
+			}
+			return nil
+		case "best":
+			if exp.BestCheckpoint != nil {
+				if val, ok := exp.BestCheckpoint.Metrics[rest]; ok {
+					return val
+				}
+			}
+			return nil
+		}
+	}
+
 	if name == "started" {
 		// floating point timestamp used in sorting
 		return param.Float(float64(exp.Created.Unix()))
@@ -89,25 +434,272 @@ func (exp *ListExperiment) GetValue(name string) *param.Value {
 	return nil
 }
 
-func Experiments(store storage.Storage, format Format, allParams bool, filters *param.Filters, sorter *param.Sorter) error {
+// ColumnSpec is one column of list output: a display header paired with the
+// selector used to pull its value out of a ListExperiment. Selector is either
+// one of the builtin names ("id", "started", "latest.checkpoint", ...) or
+// anything ListExperiment.GetValue understands (params.<name>, metrics.<name>,
+// latest.<name>, best.<name>).
+type ColumnSpec struct {
+	Header   string
+	Selector string
+}
+
+// ColumnsFromFields builds an ordered []ColumnSpec from a user-supplied
+// --fields selection, e.g. []string{"id", "started", "params.lr"}.
+func ColumnsFromFields(fields []string) []ColumnSpec {
+	columns := make([]ColumnSpec, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		columns = append(columns, ColumnSpec{Header: strings.ToUpper(field), Selector: normalizeSelector(field)})
+	}
+	return columns
+}
+
+// normalizeSelector folds the case of known builtin selector keywords, and of
+// the params./metrics./latest./best. prefix (and the best/latest qualifier
+// nested under metrics.), so --fields=Started or --fields=METRICS.best.loss
+// match the same lowercase selectors columnValue and GetValue compare
+// against. The part of a dotted accessor naming a user-defined param or
+// metric is left exactly as typed, since those keys are case-sensitive.
+func normalizeSelector(field string) string {
+	dot := strings.IndexByte(field, '.')
+	if dot == -1 {
+		switch strings.ToLower(field) {
+		case "id", "started", "status", "host", "user", "command", "step":
+			return strings.ToLower(field)
+		}
+		return field
+	}
+
+	prefix, rest := field[:dot], field[dot+1:]
+	switch strings.ToLower(prefix) {
+	case "params":
+		return "params." + rest
+	case "latest", "best":
+		lowerPrefix := strings.ToLower(prefix)
+		switch strings.ToLower(rest) {
+		case "checkpoint", "id", "step":
+			return lowerPrefix + "." + strings.ToLower(rest)
+		}
+		return lowerPrefix + "." + rest
+	case "metrics":
+		if nestedDot := strings.IndexByte(rest, '.'); nestedDot != -1 {
+			nestedPrefix, nestedName := rest[:nestedDot], rest[nestedDot+1:]
+			switch strings.ToLower(nestedPrefix) {
+			case "best", "latest":
+				return "metrics." + strings.ToLower(nestedPrefix) + "." + nestedName
+			}
+		}
+		return "metrics." + rest
+	}
+	return field
+}
+
+// defaultColumns is the column layout used when the user hasn't passed
+// --fields: experiment/started/status/host/user, changed params, the latest
+// checkpoint and its metrics, then the best checkpoint and its metrics (if
+// any experiment has one).
+func defaultColumns(experiments []*ListExperiment, allParams bool) []ColumnSpec {
+	columns := []ColumnSpec{
+		{Header: "EXPERIMENT", Selector: "id"},
+		{Header: "STARTED", Selector: "started"},
+		{Header: "STATUS", Selector: "status"},
+		{Header: "HOST", Selector: "host"},
+		{Header: "USER", Selector: "user"},
+	}
+
+	for _, heading := range getParamsToDisplay(experiments, !allParams) {
+		columns = append(columns, ColumnSpec{Header: strings.ToUpper(heading), Selector: "params." + heading})
+	}
+
+	metricsToDisplay := getMetricsToDisplay(experiments)
+
+	columns = append(columns, ColumnSpec{Header: "LATEST CHECKPOINT", Selector: "latest.checkpoint"})
+	for _, heading := range metricsToDisplay {
+		columns = append(columns, ColumnSpec{Header: strings.ToUpper(heading), Selector: "latest." + heading})
+	}
+
+	hasBestCheckpoint := false
+	for _, exp := range experiments {
+		if exp.BestCheckpoint != nil {
+			hasBestCheckpoint = true
+			break
+		}
+	}
+	if hasBestCheckpoint {
+		columns = append(columns, ColumnSpec{Header: "BEST CHECKPOINT", Selector: "best.checkpoint"})
+		for _, heading := range metricsToDisplay {
+			columns = append(columns, ColumnSpec{Header: strings.ToUpper(heading), Selector: "best." + heading})
+		}
+	}
+
+	return columns
+}
+
+// defaultCSVColumns mirrors defaultColumns but keeps the latest/best
+// checkpoint id and step as separate numeric columns instead of the combined
+// "<id> (step N)" display string, so a CSV/TSV consumer gets a plain step
+// number to sort/aggregate on rather than having to re-parse a string.
+func defaultCSVColumns(experiments []*ListExperiment, allParams bool) []ColumnSpec {
+	columns := []ColumnSpec{
+		{Header: "experiment", Selector: "id"},
+		{Header: "started", Selector: "started"},
+		{Header: "status", Selector: "status"},
+		{Header: "host", Selector: "host"},
+		{Header: "user", Selector: "user"},
+	}
+
+	for _, heading := range getParamsToDisplay(experiments, !allParams) {
+		columns = append(columns, ColumnSpec{Header: "params." + heading, Selector: "params." + heading})
+	}
+
+	metricsToDisplay := getMetricsToDisplay(experiments)
+
+	columns = append(columns,
+		ColumnSpec{Header: "latest.checkpoint", Selector: "latest.id"},
+		ColumnSpec{Header: "latest.step", Selector: "latest.step"},
+	)
+	for _, heading := range metricsToDisplay {
+		columns = append(columns, ColumnSpec{Header: "latest." + heading, Selector: "latest." + heading})
+	}
+
+	hasBestCheckpoint := false
+	for _, exp := range experiments {
+		if exp.BestCheckpoint != nil {
+			hasBestCheckpoint = true
+			break
+		}
+	}
+	if hasBestCheckpoint {
+		columns = append(columns,
+			ColumnSpec{Header: "best.checkpoint", Selector: "best.id"},
+			ColumnSpec{Header: "best.step", Selector: "best.step"},
+		)
+		for _, heading := range metricsToDisplay {
+			columns = append(columns, ColumnSpec{Header: "best." + heading, Selector: "best." + heading})
+		}
+	}
+
+	return columns
+}
+
+// columnValue resolves a ColumnSpec's selector against exp. short controls
+// whether param/metric values are truncated for table display or rendered in
+// full (CSV, JSON).
+func (exp *ListExperiment) columnValue(selector string, short bool) string {
+	switch selector {
+	case "id":
+		if short {
+			return exp.ID[:7]
+		}
+		return exp.ID
+	case "started":
+		return console.FormatTime(exp.Created)
+	case "latest.checkpoint":
+		return checkpointString(exp.LatestCheckpoint)
+	case "best.checkpoint":
+		return checkpointString(exp.BestCheckpoint)
+	case "latest.id":
+		if exp.LatestCheckpoint != nil {
+			return exp.LatestCheckpoint.ShortID()
+		}
+		return ""
+	case "best.id":
+		if exp.BestCheckpoint != nil {
+			return exp.BestCheckpoint.ShortID()
+		}
+		return ""
+	case "latest.step":
+		if exp.LatestCheckpoint != nil {
+			return strconv.Itoa(exp.LatestCheckpoint.Step)
+		}
+		return ""
+	case "best.step":
+		if exp.BestCheckpoint != nil {
+			return strconv.Itoa(exp.BestCheckpoint.Step)
+		}
+		return ""
+	}
+
+	val := exp.GetValue(selector)
+	if val == nil {
+		return ""
+	}
+	if short {
+		return val.ShortString(valueMaxLength, valueTruncate)
+	}
+	return val.String()
+}
+
+func checkpointString(checkpoint *project.Checkpoint) string {
+	if checkpoint == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s (step %s)", checkpoint.ShortID(), strconv.Itoa(checkpoint.Step))
+}
+
+// fields is an optional ordered list of column selectors from --fields
+// (e.g. "id,started,params.lr,metrics.best.loss,status"). When empty, each
+// format falls back to its own default column layout. aggregateStats is the
+// --aggregate stat list (nil disables aggregation).
+// limit and offset implement --limit/--offset (0 means unbounded/no offset).
+// Setting limit is also what keeps memory flat as the result set grows (via
+// a bounded top-N heap); with no limit, every matching experiment is held in
+// memory at once regardless of format.
+// workers sizes the worker pool that resolves each experiment concurrently
+// (0 means runtime.GOMAXPROCS(0)).
+func Experiments(ctx context.Context, store storage.Storage, format Format, allParams bool, fields []string, aggregateStats []Stat, filters *param.Filters, sorter *param.Sorter, limit, offset, workers int) error {
 	proj := project.NewProject(store)
-	listExperiments, err := createListExperiments(proj, filters)
-	if err != nil {
-		return err
+
+	var columns []ColumnSpec
+	if len(fields) > 0 {
+		columns = ColumnsFromFields(fields)
 	}
-	sort.Slice(listExperiments, func(i, j int) bool {
-		return sorter.LessThan(listExperiments[i], listExperiments[j])
-	})
 
-	switch format {
-	case FormatJSON:
-		return outputJSON(listExperiments)
-	case FormatTable:
-		return outputTable(listExperiments, allParams)
-	case FormatQuiet:
-		return outputQuiet(listExperiments)
+	renderer, ok := renderers[string(format)]
+	if !ok {
+		return fmt.Errorf("Unknown format: %s", format)
+	}
+	opts := RenderOptions{
+		AllParams:      allParams,
+		Columns:        columns,
+		AggregateStats: aggregateStats,
 	}
-	panic(fmt.Sprintf("Unknown format: %d", format))
+
+	// FormatQuiet/FormatJSON don't need column widths or stats computed
+	// across the whole result set, so they can skip outputTable/outputCSV's
+	// bookkeeping and render straight from a StreamingRenderer. The worker
+	// pool still resolves every experiment concurrently either way; the one
+	// thing this path can't skip is sorter, since the same query against
+	// --format table/--format csv is sorted and these formats must match it.
+	// With --fields or --aggregate, jsonRenderer's RenderStream falls back to
+	// Render so the projection and summary still apply.
+	// This only applies when there's no --limit or --offset, since a bounded
+	// top-N still needs to see every candidate before it knows which ones
+	// survive.
+	//
+	// NOTE: sortedChannel below buffers every resolved experiment before it
+	// can sort and replay them, so this path's memory use is no better than
+	// createListExperiments' in the no-limit case — the worker pool here
+	// only buys concurrent resolution, not a smaller footprint. --limit's
+	// boundedHeap is the only thing that actually keeps memory flat as the
+	// result set grows; see BenchmarkBoundedHeapTopN.
+	if streaming, ok := renderer.(StreamingRenderer); ok && limit <= 0 && offset <= 0 {
+		results, errs := resolveExperimentsStream(ctx, proj, filters, workers)
+		if err := streaming.RenderStream(sortedChannel(results, sorter), opts); err != nil {
+			return err
+		}
+		return errs.get()
+	}
+
+	listExperiments, err := createListExperiments(ctx, proj, filters, sorter, limit, offset, workers)
+	if err != nil {
+		return err
+	}
+	return renderer.Render(listExperiments, opts)
 }
 
 func outputQuiet(experiments []*ListExperiment) error {
@@ -117,124 +709,210 @@ func outputQuiet(experiments []*ListExperiment) error {
 	return nil
 }
 
-func outputJSON(experiments []*ListExperiment) error {
+// outputJSON marshals experiments as-is when no columns are selected, so
+// callers who don't ask for --fields keep seeing the full ListExperiment
+// shape. With columns selected, each row is instead reduced to the requested
+// selectors, rendered in full (not truncated). With aggregateStats set, the
+// output becomes {"experiments": [...], "summary": {...}} instead of a bare
+// array.
+func outputJSON(experiments []*ListExperiment, allParams bool, columns []ColumnSpec, aggregateStats []Stat) error {
 	enc := json.NewEncoder(os.Stdout)
 	enc.SetIndent("", "  ")
-	return enc.Encode(experiments)
+
+	var experimentsValue interface{} = experiments
+	if len(columns) > 0 {
+		experimentsValue = jsonRows(experiments, columns)
+	}
+
+	if len(aggregateStats) == 0 {
+		return enc.Encode(experimentsValue)
+	}
+
+	summaryColumns := columns
+	if len(summaryColumns) == 0 {
+		summaryColumns = defaultColumns(experiments, allParams)
+	}
+	return enc.Encode(struct {
+		Experiments interface{}                   `json:"experiments"`
+		Summary     map[string]map[string]float64 `json:"summary"`
+	}{
+		Experiments: experimentsValue,
+		Summary:     summarize(experiments, summaryColumns, aggregateStats),
+	})
+}
+
+func jsonRows(experiments []*ListExperiment, columns []ColumnSpec) []map[string]string {
+	rows := make([]map[string]string, len(experiments))
+	for i, exp := range experiments {
+		row := make(map[string]string, len(columns))
+		for _, col := range columns {
+			row[col.Selector] = exp.columnValue(col.Selector, false)
+		}
+		rows[i] = row
+	}
+	return rows
+}
+
+// summarize computes the requested stats for every numeric column, keyed by
+// column selector (e.g. "params.lr", "best.loss").
+func summarize(experiments []*ListExperiment, columns []ColumnSpec, stats []Stat) map[string]map[string]float64 {
+	colStats := columnStats(experiments, columns)
+	summary := map[string]map[string]float64{}
+	for i, col := range columns {
+		if colStats[i] == nil {
+			continue
+		}
+		values := make(map[string]float64, len(stats))
+		for _, stat := range stats {
+			values[string(stat)] = colStats[i].Value(stat)
+		}
+		summary[col.Selector] = values
+	}
+	return summary
 }
 
 // output something like (TODO: this is getting very wide)
 // experiment  started             status   host      user     param-1  latest   step  metric-1  best     step  metric-1
 // 1eeeeee     10 seconds ago      running  10.1.1.1  andreas  100      3cccccc  20    0.02     2cccccc  20    0.01
 // 2eeeeee     about a second ago  stopped  10.1.1.2  andreas  200      4cccccc  5              N/A
-func outputTable(experiments []*ListExperiment, allParams bool) error {
+func outputTable(experiments []*ListExperiment, allParams bool, columns []ColumnSpec, aggregateStats []Stat) error {
 	if len(experiments) == 0 {
 		fmt.Println("No experiments found")
 		return nil
 	}
 
-	paramsToDisplay := getParamsToDisplay(experiments, !allParams)
-	metricsToDisplay := getMetricsToDisplay(experiments)
-
-	// does any experiment have a primary metric?
-	hasBestCheckpoint := false
-	for _, exp := range experiments {
-		if exp.BestCheckpoint != nil {
-			hasBestCheckpoint = true
-			break
-		}
+	if len(columns) == 0 {
+		columns = defaultColumns(experiments, allParams)
 	}
 
 	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 
-	keys := []string{"EXPERIMENT", "STARTED", "STATUS", "HOST", "USER"}
-	keys = append(keys, upper(paramsToDisplay)...)
-	keys = append(keys, "LATEST CHECKPOINT")
-	keys = append(keys, upper(metricsToDisplay)...)
-	if hasBestCheckpoint {
-		keys = append(keys, "BEST CHECKPOINT")
-		keys = append(keys, upper(metricsToDisplay)...)
-	}
-
-	for i, key := range keys {
-		fmt.Fprintf(tw, "%s", key)
-		if i < len(keys)-1 {
+	for i, col := range columns {
+		fmt.Fprintf(tw, "%s", col.Header)
+		if i < len(columns)-1 {
 			fmt.Fprint(tw, "\t")
 		}
 	}
 	fmt.Fprint(tw, "\n")
 
 	for _, exp := range experiments {
-		// experiment
-		fmt.Fprintf(tw, "%s\t", exp.ID[:7])
-
-		// started
-		fmt.Fprintf(tw, "%s\t", console.FormatTime(exp.Created))
-
-		// status
-		if exp.Running {
-			fmt.Fprint(tw, "running\t")
-		} else {
-			fmt.Fprint(tw, "stopped\t")
+		for _, col := range columns {
+			fmt.Fprintf(tw, "%s\t", exp.columnValue(col.Selector, true))
 		}
+		fmt.Fprint(tw, "\n")
+	}
 
-		// host
-		fmt.Fprintf(tw, "%s\t", exp.Host)
-
-		// user
-		fmt.Fprintf(tw, "%s\t", exp.User)
+	if len(aggregateStats) > 0 {
+		writeAggregateRows(tw, experiments, columns, aggregateStats)
+	}
 
-		// experiment params
-		for _, heading := range paramsToDisplay {
-			if val, ok := exp.Params[heading]; ok {
-				fmt.Fprint(tw, val.ShortString(valueMaxLength, valueTruncate))
-			}
-			fmt.Fprintf(tw, "\t")
-		}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
 
-		latestCheckpoint := ""
-		if exp.LatestCheckpoint != nil {
-			latestCheckpoint = fmt.Sprintf("%s (step %s)", exp.LatestCheckpoint.ShortID(), strconv.Itoa(exp.LatestCheckpoint.Step))
-		}
-		fmt.Fprintf(tw, "%s\t", latestCheckpoint)
+	return nil
+}
 
-		// latest checkpoint metrics
-		for _, heading := range metricsToDisplay {
-			val := ""
-			if exp.LatestCheckpoint != nil {
-				if v, ok := exp.LatestCheckpoint.Metrics[heading]; ok {
-					val = v.ShortString(valueMaxLength, valueTruncate)
+// writeAggregateRows appends one tabwriter row per requested stat, with the
+// stat name in the first column and the computed value in every numeric
+// column. Non-numeric columns render blank.
+func writeAggregateRows(tw *tabwriter.Writer, experiments []*ListExperiment, columns []ColumnSpec, stats []Stat) {
+	colStats := columnStats(experiments, columns)
+	for _, stat := range stats {
+		label := strings.ToUpper(string(stat))
+		for i := range columns {
+			cell := ""
+			if colStats[i] != nil {
+				cell = strconv.FormatFloat(colStats[i].Value(stat), 'f', -1, 64)
+			}
+			// Column 0 carries the stat label as well as its own value (if
+			// numeric), since it's the only place the label can go without
+			// adding an extra column that the header row doesn't have.
+			if i == 0 {
+				if cell != "" {
+					cell = label + ": " + cell
+				} else {
+					cell = label
 				}
 			}
-			fmt.Fprintf(tw, "%s\t", val)
+			fmt.Fprintf(tw, "%s\t", cell)
 		}
+		fmt.Fprint(tw, "\n")
+	}
+}
 
-		bestCheckpoint := ""
+// outputCSV writes experiments as delimiter-separated values, reusing the
+// same column selection as outputTable but rendering values in full (not
+// truncated) and emitting ISO-8601 timestamps for "started". When
+// aggregateStats is non-empty, one extra row per stat is appended, mirroring
+// outputTable's aggregate rows.
+func outputCSV(experiments []*ListExperiment, allParams bool, columns []ColumnSpec, aggregateStats []Stat, delimiter rune) error {
+	if len(columns) == 0 {
+		columns = defaultCSVColumns(experiments, allParams)
+	}
 
-		if exp.BestCheckpoint != nil {
-			bestCheckpoint = fmt.Sprintf("%s (step %s)", exp.BestCheckpoint.ShortID(), strconv.Itoa(exp.BestCheckpoint.Step))
-		}
-		fmt.Fprintf(tw, "%s\t", bestCheckpoint)
+	w := csv.NewWriter(os.Stdout)
+	w.Comma = delimiter
 
-		// best checkpoint metrics
-		for _, heading := range metricsToDisplay {
-			val := ""
-			if exp.BestCheckpoint != nil {
-				if v, ok := exp.BestCheckpoint.Metrics[heading]; ok {
-					val = v.ShortString(valueMaxLength, valueTruncate)
-				}
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.Header
+	}
+	if err := w.Write(header); err != nil {
+		return err
+	}
+
+	for _, exp := range experiments {
+		row := make([]string, len(columns))
+		for i, col := range columns {
+			if col.Selector == "started" {
+				row[i] = exp.Created.Format(time.RFC3339)
+				continue
 			}
-			fmt.Fprintf(tw, "%s\t", val)
+			row[i] = exp.columnValue(col.Selector, false)
+		}
+		if err := w.Write(row); err != nil {
+			return err
 		}
-
-		// newline!
-		fmt.Fprint(tw, "\n")
 	}
 
-	if err := tw.Flush(); err != nil {
-		return err
+	if len(aggregateStats) > 0 {
+		if err := writeCSVAggregateRows(w, experiments, columns, aggregateStats); err != nil {
+			return err
+		}
 	}
 
+	w.Flush()
+	return w.Error()
+}
+
+// writeCSVAggregateRows appends one CSV row per requested stat, the same way
+// writeAggregateRows does for the table renderer: column 0 carries the stat
+// label (plus its own value, if numeric), every other numeric column carries
+// its value, and non-numeric columns render blank.
+func writeCSVAggregateRows(w *csv.Writer, experiments []*ListExperiment, columns []ColumnSpec, stats []Stat) error {
+	colStats := columnStats(experiments, columns)
+	for _, stat := range stats {
+		label := strings.ToUpper(string(stat))
+		row := make([]string, len(columns))
+		for i := range columns {
+			cell := ""
+			if colStats[i] != nil {
+				cell = strconv.FormatFloat(colStats[i].Value(stat), 'f', -1, 64)
+			}
+			if i == 0 {
+				if cell != "" {
+					cell = label + ": " + cell
+				} else {
+					cell = label
+				}
+			}
+			row[i] = cell
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -296,53 +974,203 @@ func getMetricsToDisplay(experiments []*ListExperiment) []string {
 	return slices.StringKeys(metricsToDisplay)
 }
 
-func createListExperiments(proj *project.Project, filters *param.Filters) ([]*ListExperiment, error) {
-	experiments, err := proj.Experiments()
+// streamErr is a write-once-wins error box shared across the worker pool in
+// resolveExperimentsStream, so a storage error on one experiment can still
+// fail the whole listing instead of being silently dropped.
+type streamErr struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (e *streamErr) set(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.err == nil {
+		e.err = err
+	}
+}
+
+func (e *streamErr) get() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.err
+}
+
+// resolveExperimentsStream reads experiments off proj.ExperimentsChan and
+// resolves each one (running state, latest/best checkpoint, filter match)
+// concurrently across a pool of workers, emitting matches on the returned
+// channel in arrival order. A ExperimentIsRunning/filters.Matches error on
+// any one experiment cancels the remaining work and is recorded on the
+// returned *streamErr, which callers must check once the channel is
+// drained — matching the baseline behavior of failing the whole listing
+// rather than quietly returning a partial one.
+func resolveExperimentsStream(ctx context.Context, proj *project.Project, filters *param.Filters, workers int) (<-chan *ListExperiment, *streamErr) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	in := proj.ExperimentsChan(streamCtx)
+	out := make(chan *ListExperiment)
+	errs := &streamErr{}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for exp := range in {
+				listExperiment, err := buildListExperiment(proj, exp, filters)
+				if err != nil {
+					errs.set(err)
+					cancel()
+					continue
+				}
+				if listExperiment == nil {
+					continue
+				}
+				out <- listExperiment
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		cancel()
+		close(out)
+	}()
+
+	return out, errs
+}
+
+// sortedChannel drains results, sorts them with sorter, and replays them on a
+// new (already-closed-at-drain) channel. Any StreamingRenderer needs this
+// whenever sort order matters (i.e. always, per the CLI's --sort contract) —
+// genuinely unbounded streaming is only safe when output order doesn't need
+// to match the rest of the result set, which isn't the case here.
+func sortedChannel(results <-chan *ListExperiment, sorter *param.Sorter) <-chan *ListExperiment {
+	buffered := []*ListExperiment{}
+	for exp := range results {
+		buffered = append(buffered, exp)
+	}
+	sort.Slice(buffered, func(i, j int) bool {
+		return sorter.LessThan(buffered[i], buffered[j])
+	})
+
+	out := make(chan *ListExperiment, len(buffered))
+	for _, exp := range buffered {
+		out <- exp
+	}
+	close(out)
+	return out
+}
+
+// buildListExperiment resolves the storage-backed fields of exp (running
+// state, latest/best checkpoint) and applies filters, returning (nil, nil)
+// when exp doesn't match.
+func buildListExperiment(proj *project.Project, exp *project.Experiment, filters *param.Filters) (*ListExperiment, error) {
+	listExperiment := &ListExperiment{
+		ID:      exp.ID,
+		Params:  exp.Params,
+		Command: exp.Command,
+		Created: exp.Created,
+		Host:    exp.Host,
+		User:    exp.User,
+		Config:  exp.Config,
+	}
+	running, err := proj.ExperimentIsRunning(exp.ID)
 	if err != nil {
 		return nil, err
 	}
-	ret := []*ListExperiment{}
-	for _, exp := range experiments {
-		listExperiment := &ListExperiment{
-			ID:      exp.ID,
-			Params:  exp.Params,
-			Command: exp.Command,
-			Created: exp.Created,
-			Host:    exp.Host,
-			User:    exp.User,
-			Config:  exp.Config,
-		}
-		running, err := proj.ExperimentIsRunning(exp.ID)
-		if err != nil {
-			return nil, err
-		}
-		listExperiment.LatestCheckpoint = exp.LatestCheckpoint()
-		listExperiment.BestCheckpoint = exp.BestCheckpoint()
-		listExperiment.NumCheckpoints = len(exp.Checkpoints)
-		listExperiment.Running = running
+	listExperiment.LatestCheckpoint = exp.LatestCheckpoint()
+	listExperiment.BestCheckpoint = exp.BestCheckpoint()
+	listExperiment.NumCheckpoints = len(exp.Checkpoints)
+	listExperiment.Running = running
 
-		match, err := filters.Matches(listExperiment)
-		if err != nil {
+	match, err := filters.Matches(listExperiment)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
+		return nil, nil
+	}
+	return listExperiment, nil
+}
+
+// createListExperiments resolves every matching experiment and returns them
+// sorted and sliced to [offset:offset+limit]. When limit is set, it avoids a
+// full sort.Slice over every experiment by keeping only the best
+// limit+offset candidates in a bounded heap as they stream in.
+func createListExperiments(ctx context.Context, proj *project.Project, filters *param.Filters, sorter *param.Sorter, limit, offset, workers int) ([]*ListExperiment, error) {
+	results, errs := resolveExperimentsStream(ctx, proj, filters, workers)
+
+	if limit <= 0 {
+		ret := []*ListExperiment{}
+		for exp := range results {
+			ret = append(ret, exp)
+		}
+		if err := errs.get(); err != nil {
 			return nil, err
 		}
-		if !match {
-			continue
+		sort.Slice(ret, func(i, j int) bool {
+			return sorter.LessThan(ret[i], ret[j])
+		})
+		if offset > 0 {
+			if offset >= len(ret) {
+				return []*ListExperiment{}, nil
+			}
+			ret = ret[offset:]
+		}
+		return ret, nil
+	}
+
+	h := &boundedHeap{less: func(a, b *ListExperiment) bool { return sorter.LessThan(a, b) }}
+	capacity := limit + offset
+	for exp := range results {
+		if h.Len() < capacity {
+			heap.Push(h, exp)
+		} else if sorter.LessThan(exp, h.items[0]) {
+			heap.Pop(h)
+			heap.Push(h, exp)
 		}
-		ret = append(ret, listExperiment)
+	}
+	if err := errs.get(); err != nil {
+		return nil, err
 	}
 
+	ret := h.items
 	sort.Slice(ret, func(i, j int) bool {
-		return ret[i].Created.Before(ret[j].Created)
+		return sorter.LessThan(ret[i], ret[j])
 	})
-
+	if offset > 0 {
+		if offset >= len(ret) {
+			return []*ListExperiment{}, nil
+		}
+		ret = ret[offset:]
+	}
 	return ret, nil
+}
 
+// boundedHeap keeps the `less`-smallest items seen so far, up to a fixed
+// capacity, so --limit doesn't require sorting the full result set. It's a
+// max-heap over `less`: the root (index 0) is always the worst of the
+// retained items, so it's the one evicted when a better candidate arrives.
+type boundedHeap struct {
+	items []*ListExperiment
+	less  func(a, b *ListExperiment) bool
 }
 
-func upper(in []string) []string {
-	ret := make([]string, len(in))
-	for i, s := range in {
-		ret[i] = strings.ToUpper(s)
-	}
-	return ret
+func (h *boundedHeap) Len() int { return len(h.items) }
+func (h *boundedHeap) Less(i, j int) bool {
+	return h.less(h.items[j], h.items[i])
+}
+func (h *boundedHeap) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *boundedHeap) Push(x interface{}) {
+	h.items = append(h.items, x.(*ListExperiment))
+}
+func (h *boundedHeap) Pop() interface{} {
+	old := h.items
+	n := len(old)
+	item := old[n-1]
+	h.items = old[:n-1]
+	return item
 }
@@ -0,0 +1,207 @@
+package list
+
+import (
+	"container/heap"
+	"math"
+	"testing"
+	"time"
+
+	"replicate.ai/cli/pkg/param"
+	"replicate.ai/cli/pkg/project"
+)
+
+func TestGetValueDottedAccessors(t *testing.T) {
+	exp := &ListExperiment{
+		Params: map[string]*param.Value{
+			"lr": param.Float(0.1),
+		},
+		LatestCheckpoint: &project.Checkpoint{
+			Metrics: map[string]*param.Value{
+				"loss": param.Float(0.5),
+			},
+		},
+		BestCheckpoint: &project.Checkpoint{
+			Metrics: map[string]*param.Value{
+				"loss": param.Float(0.1),
+			},
+		},
+	}
+
+	cases := []struct {
+		selector string
+		want     string
+	}{
+		{"params.lr", "0.1"},
+		{"latest.loss", "0.5"},
+		{"best.loss", "0.1"},
+		{"metrics.loss", "0.1"},      // no checkpoint qualifier defaults to best
+		{"metrics.best.loss", "0.1"}, // three-segment form from --fields examples
+		{"metrics.latest.loss", "0.5"},
+		{"params.missing", ""},
+	}
+
+	for _, c := range cases {
+		val := exp.GetValue(c.selector)
+		got := ""
+		if val != nil {
+			got = val.String()
+		}
+		if got != c.want {
+			t.Errorf("GetValue(%q) = %q, want %q", c.selector, got, c.want)
+		}
+	}
+}
+
+func TestColumnsFromFieldsNormalizesCase(t *testing.T) {
+	cases := []struct {
+		field string
+		want  string
+	}{
+		{"Started", "started"},
+		{"STATUS", "status"},
+		{"Id", "id"},
+		{"Params.lr", "params.lr"},
+		{"params.LR", "params.LR"}, // user-defined param name keeps its case
+		{"Latest.Checkpoint", "latest.checkpoint"},
+		{"BEST.step", "best.step"},
+		{"Metrics.Best.loss", "metrics.best.loss"},
+		{"metrics.LATEST.loss", "metrics.latest.loss"},
+		{"metrics.loss", "metrics.loss"}, // user-defined metric name keeps its case
+	}
+
+	for _, c := range cases {
+		columns := ColumnsFromFields([]string{c.field})
+		if len(columns) != 1 {
+			t.Fatalf("ColumnsFromFields([%q]) returned %d columns, want 1", c.field, len(columns))
+		}
+		if got := columns[0].Selector; got != c.want {
+			t.Errorf("ColumnsFromFields([%q])[0].Selector = %q, want %q", c.field, got, c.want)
+		}
+	}
+}
+
+func TestRunningStatsWelford(t *testing.T) {
+	values := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	rs := newRunningStats()
+	for _, v := range values {
+		rs.Add(v)
+	}
+
+	if rs.Value(StatMin) != 2 {
+		t.Errorf("min = %v, want 2", rs.Value(StatMin))
+	}
+	if rs.Value(StatMax) != 9 {
+		t.Errorf("max = %v, want 9", rs.Value(StatMax))
+	}
+	if mean := rs.Value(StatMean); math.Abs(mean-5) > 1e-9 {
+		t.Errorf("mean = %v, want 5", mean)
+	}
+	// sample stddev (Bessel's correction, n-1), not population stddev
+	if stddev := rs.Value(StatStddev); math.Abs(stddev-2.138089935) > 1e-9 {
+		t.Errorf("stddev = %v, want 2.138089935", stddev)
+	}
+	if median := rs.Value(StatMedian); math.Abs(median-4.5) > 1e-9 {
+		t.Errorf("median = %v, want 4.5", median)
+	}
+}
+
+func TestBoundedHeapKeepsSmallest(t *testing.T) {
+	now := time.Now()
+	const n = 50
+	const capacity = 5
+
+	less := func(a, b *ListExperiment) bool { return a.Created.Before(b.Created) }
+
+	exps := make([]*ListExperiment, n)
+	for i := 0; i < n; i++ {
+		// shuffle Created so index order != iteration order
+		offset := (i * 37) % n
+		exps[i] = &ListExperiment{Created: now.Add(time.Duration(offset) * time.Second)}
+	}
+
+	h := &boundedHeap{less: less}
+	for _, exp := range exps {
+		if h.Len() < capacity {
+			heap.Push(h, exp)
+		} else if less(exp, h.items[0]) {
+			heap.Pop(h)
+			heap.Push(h, exp)
+		}
+	}
+
+	want := make([]time.Time, n)
+	for i, exp := range exps {
+		want[i] = exp.Created
+	}
+	timeSort(want)
+	want = want[:capacity]
+
+	got := make([]time.Time, len(h.items))
+	for i, exp := range h.items {
+		got[i] = exp.Created
+	}
+	timeSort(got)
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d items, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("item %d: got Created %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func timeSort(times []time.Time) {
+	for i := 1; i < len(times); i++ {
+		for j := i; j > 0 && times[j].Before(times[j-1]); j-- {
+			times[j], times[j-1] = times[j-1], times[j]
+		}
+	}
+}
+
+// BenchmarkBoundedHeapTopN shows that boundedHeap's retained set stays
+// capped at capacity regardless of how many candidates it sees: B/op grows
+// with total here only because each candidate is still allocated once, not
+// because the heap itself grows past len(h.items) == capacity — which is
+// exactly what lets createListExperiments skip a full sort.Slice over every
+// experiment when --limit is set.
+func BenchmarkBoundedHeapTopN(b *testing.B) {
+	const capacity = 10
+	less := func(a, b *ListExperiment) bool { return a.Created.Before(b.Created) }
+	now := time.Now()
+
+	for _, total := range []int{100, 10000, 1000000} {
+		total := total
+		b.Run(benchName(total), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				h := &boundedHeap{less: less}
+				for j := 0; j < total; j++ {
+					exp := &ListExperiment{Created: now.Add(time.Duration(-j) * time.Second)}
+					if h.Len() < capacity {
+						heap.Push(h, exp)
+					} else if less(exp, h.items[0]) {
+						heap.Pop(h)
+						heap.Push(h, exp)
+					}
+				}
+				if h.Len() != capacity {
+					b.Fatalf("heap retained %d items, want %d regardless of total=%d", h.Len(), capacity, total)
+				}
+			}
+		})
+	}
+}
+
+func benchName(total int) string {
+	switch {
+	case total >= 1000000:
+		return "total=1e6"
+	case total >= 10000:
+		return "total=1e4"
+	default:
+		return "total=1e2"
+	}
+}
@@ -0,0 +1,37 @@
+package project
+
+import (
+	"context"
+
+	"replicate.ai/cli/pkg/console"
+)
+
+// ExperimentsChan streams every experiment in the project over a channel,
+// closing it once they've all been sent or ctx is cancelled. It waits for
+// Experiments to return the full slice before sending the first experiment,
+// so nothing is sent while experiments are still being read from storage;
+// callers like pkg/cli/list's worker pool resolve the loaded experiments
+// concurrently as they receive them.
+//
+// Experiments is still what actually loads the list, so a storage error
+// there has nowhere to go but console.Warn; callers that need a hard failure
+// on a broken listing should keep using Experiments directly.
+func (p *Project) ExperimentsChan(ctx context.Context) <-chan *Experiment {
+	out := make(chan *Experiment)
+	go func() {
+		defer close(out)
+		experiments, err := p.Experiments()
+		if err != nil {
+			console.Warn("%s", err)
+			return
+		}
+		for _, exp := range experiments {
+			select {
+			case out <- exp:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}